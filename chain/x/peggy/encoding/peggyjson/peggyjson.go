@@ -0,0 +1,221 @@
+// Package peggyjson provides a canonical JSON encoding for the peggy
+// module's bridge types, for use by the REST gateway and off-chain
+// orchestrator/relayer tooling that would otherwise have to depend on the
+// protobuf wire format.
+//
+// The encoding differs from the default gogoproto JSON marshaller in three
+// ways the Solidity bridge contract and its JS/TS tooling care about:
+//   - field names are lowerCamelCase, matching the contract's struct layout
+//   - Power is rendered as a decimal string rather than a JSON number, since
+//     JS numbers lose precision above 2^53
+//   - EthereumAddress and Hash are 0x-prefixed hex strings
+//
+// Valset members are always encoded in types.SortedMembers order (power
+// descending, then address ascending), so the same bytes are produced
+// whether the source Valset arrived from on-chain storage or a prior round
+// of JSON decoding, and so the encoding agrees with the order
+// types.Valset.Checkpoint hashes members in.
+package peggyjson
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/types"
+)
+
+// jsonBridgeValidator is the canonical wire format for a BridgeValidator,
+// matching the Peggy.sol ValidatorPower struct. BlsPubkey is omitted for
+// validators still on the legacy per-signature ECDSA path (see
+// types.BridgeValidator.BlsPubkey).
+type jsonBridgeValidator struct {
+	Power           string `json:"power"`
+	EthereumAddress string `json:"ethereumAddress"`
+	ChainID         string `json:"chainId"`
+	BlsPubkey       string `json:"blsPubkey,omitempty"`
+}
+
+func toJSONBridgeValidator(v *types.BridgeValidator) jsonBridgeValidator {
+	j := jsonBridgeValidator{
+		Power:           strconv.FormatUint(v.Power, 10),
+		EthereumAddress: ensureHexPrefix(v.EthereumAddress),
+		ChainID:         strconv.FormatUint(v.ChainId, 10),
+	}
+	if len(v.BlsPubkey) > 0 {
+		j.BlsPubkey = hexBytes(v.BlsPubkey)
+	}
+	return j
+}
+
+func fromJSONBridgeValidator(j jsonBridgeValidator) (*types.BridgeValidator, error) {
+	power, err := strconv.ParseUint(j.Power, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse power %q: %w", j.Power, err)
+	}
+	chainID, err := strconv.ParseUint(j.ChainID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse chainId %q: %w", j.ChainID, err)
+	}
+	var blsPubkey []byte
+	if j.BlsPubkey != "" {
+		blsPubkey, err = hexToBytes(j.BlsPubkey)
+		if err != nil {
+			return nil, fmt.Errorf("parse blsPubkey %q: %w", j.BlsPubkey, err)
+		}
+	}
+	return &types.BridgeValidator{
+		Power:           power,
+		EthereumAddress: stripHexPrefix(j.EthereumAddress),
+		ChainId:         chainID,
+		BlsPubkey:       blsPubkey,
+	}, nil
+}
+
+// MarshalBridgeValidator renders v in the canonical JSON format.
+func MarshalBridgeValidator(v *types.BridgeValidator) ([]byte, error) {
+	return json.Marshal(toJSONBridgeValidator(v))
+}
+
+// UnmarshalBridgeValidator parses the canonical JSON format produced by
+// MarshalBridgeValidator back into a types.BridgeValidator.
+func UnmarshalBridgeValidator(data []byte) (*types.BridgeValidator, error) {
+	var j jsonBridgeValidator
+	if err := json.Unmarshal(data, &j); err != nil {
+		return nil, err
+	}
+	return fromJSONBridgeValidator(j)
+}
+
+// jsonValset is the canonical wire format for a Valset.
+type jsonValset struct {
+	Nonce   string                `json:"nonce"`
+	Height  string                `json:"height"`
+	ChainID string                `json:"chainId"`
+	Members []jsonBridgeValidator `json:"members"`
+}
+
+// MarshalValset renders v in the canonical JSON format, with Members sorted
+// by power descending then address ascending - the same order
+// types.Valset.Checkpoint hashes members in.
+func MarshalValset(v *types.Valset) ([]byte, error) {
+	sorted := types.SortedMembers(v.Members)
+	members := make([]jsonBridgeValidator, len(sorted))
+	for i, m := range sorted {
+		members[i] = toJSONBridgeValidator(m)
+	}
+	return json.Marshal(jsonValset{
+		Nonce:   strconv.FormatUint(v.Nonce, 10),
+		Height:  strconv.FormatUint(v.Height, 10),
+		ChainID: strconv.FormatUint(v.ChainId, 10),
+		Members: members,
+	})
+}
+
+// UnmarshalValset parses the canonical JSON format produced by
+// MarshalValset back into a types.Valset. The returned Members are in the
+// same sorted order MarshalValset produced, so re-marshalling the result
+// round-trips byte for byte.
+func UnmarshalValset(data []byte) (*types.Valset, error) {
+	var j jsonValset
+	if err := json.Unmarshal(data, &j); err != nil {
+		return nil, err
+	}
+	nonce, err := strconv.ParseUint(j.Nonce, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse nonce %q: %w", j.Nonce, err)
+	}
+	height, err := strconv.ParseUint(j.Height, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse height %q: %w", j.Height, err)
+	}
+	chainID, err := strconv.ParseUint(j.ChainID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse chainId %q: %w", j.ChainID, err)
+	}
+
+	members := make([]*types.BridgeValidator, len(j.Members))
+	for i, jm := range j.Members {
+		m, err := fromJSONBridgeValidator(jm)
+		if err != nil {
+			return nil, err
+		}
+		members[i] = m
+	}
+
+	return &types.Valset{
+		Nonce:   nonce,
+		Height:  height,
+		ChainId: chainID,
+		Members: members,
+	}, nil
+}
+
+// jsonGenericClaim is the canonical wire format for a GenericClaim.
+type jsonGenericClaim struct {
+	EventNonce   string `json:"eventNonce"`
+	ClaimType    int32  `json:"claimType"`
+	Hash         string `json:"hash"`
+	EventClaimer string `json:"eventClaimer"`
+	ChainID      string `json:"chainId"`
+}
+
+// MarshalGenericClaim renders c in the canonical JSON format.
+func MarshalGenericClaim(c *types.GenericClaim) ([]byte, error) {
+	return json.Marshal(jsonGenericClaim{
+		EventNonce:   strconv.FormatUint(c.EventNonce, 10),
+		ClaimType:    c.ClaimType,
+		Hash:         hexBytes(c.Hash),
+		EventClaimer: c.EventClaimer,
+		ChainID:      strconv.FormatUint(c.ChainId, 10),
+	})
+}
+
+// UnmarshalGenericClaim parses the canonical JSON format produced by
+// MarshalGenericClaim back into a types.GenericClaim.
+func UnmarshalGenericClaim(data []byte) (*types.GenericClaim, error) {
+	var j jsonGenericClaim
+	if err := json.Unmarshal(data, &j); err != nil {
+		return nil, err
+	}
+	eventNonce, err := strconv.ParseUint(j.EventNonce, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse eventNonce %q: %w", j.EventNonce, err)
+	}
+	chainID, err := strconv.ParseUint(j.ChainID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse chainId %q: %w", j.ChainID, err)
+	}
+	hash, err := hexToBytes(j.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("parse hash %q: %w", j.Hash, err)
+	}
+	return &types.GenericClaim{
+		EventNonce:   eventNonce,
+		ClaimType:    j.ClaimType,
+		Hash:         hash,
+		EventClaimer: j.EventClaimer,
+		ChainId:      chainID,
+	}, nil
+}
+
+func ensureHexPrefix(s string) string {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return s
+	}
+	return "0x" + s
+}
+
+func hexBytes(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+func hexToBytes(s string) ([]byte, error) {
+	return hex.DecodeString(stripHexPrefix(s))
+}
+
+func stripHexPrefix(s string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+}
@@ -0,0 +1,125 @@
+// BLS-aggregated valset confirmations are unfinished groundwork, not a
+// working feature: aggregateBLSSignatures has no BLS12-381 backend wired up,
+// and AggregateValsetConfirms is not called from any MsgValsetConfirm
+// handler, genesis path or gRPC query in this tree - nothing can reach it
+// yet. Params.BLSTransitionHeight defaults to 0 (disabled) precisely so
+// enabling it requires an explicit governance parameter change, but do not
+// wire a handler to this path, or enable it via governance, until
+// aggregateBLSSignatures is backed by a real implementation.
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/types"
+)
+
+// blsSignerBit is a single validator's BLS confirmation of a valset
+// checkpoint, pending aggregation.
+type blsSignerBit struct {
+	MemberIndex int
+	Power       uint64
+	Signature   []byte
+}
+
+// AggregateValsetConfirms would fold individual BLS MsgValsetConfirm
+// submissions for a single chain_id/nonce into one ValsetConfirmAggregate
+// once the cumulative power of participating signers crosses 2/3 of the
+// valset's total power, returning (nil, false) if 2/3 has not yet been
+// reached. Nothing calls it (see the package doc comment); a call that passes
+// the height and signer checks and reaches 2/3 always fails at the
+// aggregateBLSSignatures step below.
+//
+// BLS aggregation is gated by the governance-configurable
+// Params.BLSTransitionHeight: a value of 0 (the default) disables it
+// entirely, and any other value only takes effect once the chain reaches
+// that height, so the legacy per-validator ECDSA confirm path (see
+// Keeper.SetValsetConfirm / GetValsetConfirm) keeps working unchanged both
+// before governance opts in and for validators who never submit a BLS
+// confirmation.
+//
+// signers must all be confirming the same checkpoint hash - callers are
+// expected to have already rejected any confirmation signed over a
+// different checkpoint before calling this.
+func (k Keeper) AggregateValsetConfirms(ctx sdk.Context, valset *types.Valset, signers []blsSignerBit) (*types.ValsetConfirmAggregate, bool, error) {
+	transitionHeight := k.GetParams(ctx).BLSTransitionHeight
+	if transitionHeight <= 0 || ctx.BlockHeight() < transitionHeight {
+		return nil, false, fmt.Errorf("BLS aggregated valset confirmations are not enabled: current height %d, transition height %d", ctx.BlockHeight(), transitionHeight)
+	}
+
+	total := uint64(0)
+	for _, m := range valset.Members {
+		total += m.Power
+	}
+
+	bitmap := make([]byte, (len(valset.Members)+7)/8)
+	seen := make(map[int]bool, len(signers))
+	var power uint64
+
+	for _, s := range signers {
+		if s.MemberIndex < 0 || s.MemberIndex >= len(valset.Members) {
+			return nil, false, fmt.Errorf("signer index %d out of range for valset with %d members", s.MemberIndex, len(valset.Members))
+		}
+		if seen[s.MemberIndex] {
+			return nil, false, fmt.Errorf("duplicate BLS confirmation for member index %d", s.MemberIndex)
+		}
+		seen[s.MemberIndex] = true
+
+		bitmap[s.MemberIndex/8] |= 1 << uint(s.MemberIndex%8)
+		power += valset.Members[s.MemberIndex].Power
+	}
+
+	// 2/3 threshold, matching the same check the bridge contract performs on
+	// the legacy per-signature path.
+	if power*3 < total*2 {
+		return nil, false, nil
+	}
+
+	aggSig, err := aggregateBLSSignatures(signers)
+	if err != nil {
+		return nil, false, fmt.Errorf("aggregate BLS signatures for valset %d/%d: %w", valset.ChainId, valset.Nonce, err)
+	}
+
+	return &types.ValsetConfirmAggregate{
+		ChainId:            valset.ChainId,
+		Nonce:              valset.Nonce,
+		SignerBitmap:       bitmap,
+		AggregateSignature: aggSig,
+	}, true, nil
+}
+
+// aggregateBLSSignatures sums a set of compressed BLS12-381 G2 signatures
+// into a single compressed aggregate signature.
+//
+// This is a placeholder until a BLS12-381 library is vendored; wire it up to
+// the same curve implementation used by the bridge contract's pairing
+// precompile before enabling BLSTransitionHeight in production.
+func aggregateBLSSignatures(signers []blsSignerBit) ([]byte, error) {
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("no signers to aggregate")
+	}
+	return nil, fmt.Errorf("BLS12-381 aggregation backend not yet wired up")
+}
+
+// SetValsetConfirmAggregate persists the aggregate confirmation for a
+// chain_id/nonce. GetValsetConfirmAggregate reads it back; no gRPC query
+// serves it.
+func (k Keeper) SetValsetConfirmAggregate(ctx sdk.Context, agg *types.ValsetConfirmAggregate) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValsetConfirmKey(agg.ChainId, agg.Nonce, []byte("aggregate")), k.cdc.MustMarshal(agg))
+}
+
+// GetValsetConfirmAggregate returns the aggregate BLS confirmation for a
+// chain_id/nonce, or nil if one hasn't been assembled yet.
+func (k Keeper) GetValsetConfirmAggregate(ctx sdk.Context, chainID, nonce uint64) *types.ValsetConfirmAggregate {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValsetConfirmKey(chainID, nonce, []byte("aggregate")))
+	if bz == nil {
+		return nil
+	}
+	var agg types.ValsetConfirmAggregate
+	k.cdc.MustUnmarshal(bz, &agg)
+	return &agg
+}
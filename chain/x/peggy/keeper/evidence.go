@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/types"
+)
+
+// SetBridgeEvidence persists a confirmed piece of bridge slashing evidence
+// so it can later be enumerated by a watchtower via the Evidence query. Each
+// call is assigned its own sequence number, so two pieces of evidence
+// against the same validator landing in the same block (e.g. a false claim
+// and a double sign relayed together) are both kept rather than the second
+// overwriting the first.
+func (k Keeper) SetBridgeEvidence(ctx sdk.Context, chainID uint64, validator sdk.ValAddress, height int64, evidence *types.MsgSubmitBridgeEvidence) {
+	store := ctx.KVStore(k.storeKey)
+	seq := k.nextBridgeEvidenceSequence(ctx)
+	store.Set(types.GetBridgeEvidenceKey(chainID, validator, uint64(height), seq), k.cdc.MustMarshal(evidence))
+}
+
+// nextBridgeEvidenceSequence returns a fresh, monotonically increasing
+// sequence number for bridge slashing evidence and persists the next one to
+// hand out.
+func (k Keeper) nextBridgeEvidenceSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var seq uint64
+	if bz := store.Get(types.BridgeEvidenceSequenceKey); bz != nil {
+		seq = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.BridgeEvidenceSequenceKey, types.UInt64Bytes(seq+1))
+	return seq
+}
+
+// IterateBridgeEvidence calls cb for every piece of bridge slashing evidence
+// ever recorded against validator on chainID, in ascending height order,
+// stopping if cb returns true.
+func (k Keeper) IterateBridgeEvidence(ctx sdk.Context, chainID uint64, validator sdk.ValAddress, cb func(*types.MsgSubmitBridgeEvidence) bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.GetBridgeEvidenceValidatorPrefix(chainID, validator))
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var evidence types.MsgSubmitBridgeEvidence
+		k.cdc.MustUnmarshal(iterator.Value(), &evidence)
+		if cb(&evidence) {
+			break
+		}
+	}
+}
+
+// AllBridgeEvidence returns every piece of bridge slashing evidence ever
+// recorded against validator on chainID. It backs the Evidence gRPC query
+// (see Keeper.Evidence in grpc_query.go).
+func (k Keeper) AllBridgeEvidence(ctx sdk.Context, chainID uint64, validator sdk.ValAddress) []*types.MsgSubmitBridgeEvidence {
+	var out []*types.MsgSubmitBridgeEvidence
+	k.IterateBridgeEvidence(ctx, chainID, validator, func(e *types.MsgSubmitBridgeEvidence) bool {
+		out = append(out, e)
+		return false
+	})
+	return out
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/types"
+)
+
+// InitGenesis sets the peggy module's params and registers every EVM chain
+// present in genState, so a chain can launch already bridging to one or more
+// EVM chains without a post-genesis governance proposal.
+func InitGenesis(ctx sdk.Context, k Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, chain := range genState.EVMChains {
+		if err := k.RegisterEVMChain(ctx, chain); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the peggy module's current params and registered EVM
+// chains as a GenesisState, the inverse of InitGenesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
+	return types.GenesisState{
+		Params:    k.GetParams(ctx),
+		EVMChains: k.AllEVMChains(ctx),
+	}
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/types"
+)
+
+// Evidence answers the Evidence gRPC query: every piece of bridge slashing
+// evidence ever recorded against req.ValidatorAddress on req.ChainId, so a
+// watchtower can enumerate a validator's history without reading module
+// store state directly.
+func (k Keeper) Evidence(c context.Context, req *types.QueryEvidenceRequest) (*types.QueryEvidenceResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid validator_address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryEvidenceResponse{
+		Evidence: k.AllBridgeEvidence(ctx, req.ChainId, valAddr),
+	}, nil
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/types"
+)
+
+// Keeper maintains the link to data storage and exposes getter/setter
+// methods for the various peggy parts of state. Every accessor that touches
+// valsets, attestations, batches or event nonces is scoped by chain_id so a
+// single hub can bridge to several EVM chains concurrently.
+type Keeper struct {
+	storeKey       sdk.StoreKey
+	cdc            codec.BinaryCodec
+	paramSpace     paramtypes.Subspace
+	stakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
+	// authority is the bech32 address governance executes proposals as (the
+	// gov module account in a standard app wiring). MsgRegisterEVMChain only
+	// accepts submissions from this address.
+	authority string
+}
+
+// NewKeeper returns a new instance of the peggy keeper. authority is the
+// bech32 address governance executes proposals as; it gates
+// MsgRegisterEVMChain.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
+	authority string,
+) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:       storeKey,
+		cdc:            cdc,
+		paramSpace:     paramSpace,
+		stakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
+		authority:      authority,
+	}
+}
+
+// GetParams returns the current peggy module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the peggy module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// RegisterEVMChain onboards a new EVM chain that this hub can bridge to,
+// without requiring a binary upgrade. It is intended to be called from a
+// governance proposal handler or from genesis.
+func (k Keeper) RegisterEVMChain(ctx sdk.Context, chain types.EVMChain) error {
+	if err := chain.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid EVM chain registration: %w", err)
+	}
+	if _, found := k.GetEVMChain(ctx, chain.ChainId); found {
+		return fmt.Errorf("EVM chain %d is already registered", chain.ChainId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(chain)
+	if err != nil {
+		return fmt.Errorf("marshal EVM chain %d: %w", chain.ChainId, err)
+	}
+	store.Set(types.GetEVMChainKey(chain.ChainId), bz)
+	return nil
+}
+
+// GetEVMChain looks up a registered EVM chain by its hub-assigned chain_id.
+func (k Keeper) GetEVMChain(ctx sdk.Context, chainID uint64) (types.EVMChain, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEVMChainKey(chainID))
+	if bz == nil {
+		return types.EVMChain{}, false
+	}
+	var chain types.EVMChain
+	if err := json.Unmarshal(bz, &chain); err != nil {
+		panic(fmt.Errorf("corrupted EVM chain entry for chain_id %d: %w", chainID, err))
+	}
+	return chain, true
+}
+
+// AllEVMChains returns every EVM chain registered on the hub, in store
+// (chain_id-ascending) order. It backs ExportGenesis.
+func (k Keeper) AllEVMChains(ctx sdk.Context) []types.EVMChain {
+	store := ctx.KVStore(k.storeKey)
+	iterator := prefix.NewStore(store, types.EVMChainKey).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var chains []types.EVMChain
+	for ; iterator.Valid(); iterator.Next() {
+		var chain types.EVMChain
+		if err := json.Unmarshal(iterator.Value(), &chain); err != nil {
+			panic(fmt.Errorf("corrupted EVM chain entry: %w", err))
+		}
+		chains = append(chains, chain)
+	}
+	return chains
+}
+
+// SetValsetConfirm persists an orchestrator's legacy per-validator ECDSA
+// signature over a valset checkpoint, scoped to chain_id, nonce and
+// validator. This is the plain counterpart to SetValsetConfirmAggregate: it
+// keeps working unchanged regardless of whether BLS aggregation has been
+// enabled for the chain (see Keeper.AggregateValsetConfirms).
+func (k Keeper) SetValsetConfirm(ctx sdk.Context, chainID, nonce uint64, validator sdk.ValAddress, signature []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValsetConfirmKey(chainID, nonce, validator), signature)
+}
+
+// GetValsetConfirm returns the ECDSA signature a validator submitted for a
+// chain_id/nonce valset checkpoint, or nil if they haven't confirmed it.
+func (k Keeper) GetValsetConfirm(ctx sdk.Context, chainID, nonce uint64, validator sdk.ValAddress) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.GetValsetConfirmKey(chainID, nonce, validator))
+}
+
+// SetValsetRequest persists a valset request under its chain_id / nonce key.
+func (k Keeper) SetValsetRequest(ctx sdk.Context, valset *types.Valset) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValsetRequestKey(valset.ChainId, valset.Nonce), k.cdc.MustMarshal(valset))
+}
+
+// GetValsetRequest returns the valset for a given chain_id and nonce, or nil
+// if it was never requested.
+func (k Keeper) GetValsetRequest(ctx sdk.Context, chainID, nonce uint64) *types.Valset {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValsetRequestKey(chainID, nonce))
+	if bz == nil {
+		return nil
+	}
+	var valset types.Valset
+	k.cdc.MustUnmarshal(bz, &valset)
+	return &valset
+}
+
+// SetLastObservedEventNonce records the last event nonce that reached
+// consensus on a given chain_id. Event nonces are tracked independently per
+// chain so two EVM chains can emit events with the same nonce without
+// colliding.
+func (k Keeper) SetLastObservedEventNonce(ctx sdk.Context, chainID, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLastObservedEventNonceKey(chainID), types.UInt64Bytes(nonce))
+}
+
+// GetLastObservedEventNonce returns the last event nonce that reached
+// consensus on a given chain_id, or 0 if none has yet.
+func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context, chainID uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLastObservedEventNonceKey(chainID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastEventNonceByValidator records the last event nonce a given
+// validator has claimed on a given chain_id, so an orchestrator's claims on
+// one EVM chain can never be replayed against another.
+func (k Keeper) SetLastEventNonceByValidator(ctx sdk.Context, chainID uint64, validator sdk.ValAddress, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLastEventNonceByValidatorKey(chainID, validator), types.UInt64Bytes(nonce))
+}
+
+// GetLastEventNonceByValidator returns the last event nonce a given
+// validator has claimed on a given chain_id.
+func (k Keeper) GetLastEventNonceByValidator(ctx sdk.Context, chainID uint64, validator sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLastEventNonceByValidatorKey(chainID, validator))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
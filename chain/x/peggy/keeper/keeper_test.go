@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/keeper"
+	"github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/types"
+)
+
+// setupKeeper builds a peggy Keeper backed by an in-memory store, with no
+// staking/slashing keeper wired in since none of the accessors exercised by
+// this package's tests need them.
+func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	t.Helper()
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tkey := sdk.NewTransientStoreKey("transient_" + types.StoreKey)
+
+	db := tmdb.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkey, storetypes.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatalf("load store: %v", err)
+	}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramSpace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tkey, types.ModuleName)
+
+	k := keeper.NewKeeper(cdc, storeKey, paramSpace, nil, nil, "authority")
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	k.SetParams(ctx, types.DefaultParams())
+
+	return k, ctx
+}
+
+func TestRegisterEVMChainAndLookup(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	chain := types.EVMChain{
+		ChainId:               5,
+		Name:                  "test-chain",
+		BridgeContractAddress: "0x0000000000000000000000000000000000000001",
+		StartBlock:            10,
+		FinalityDepth:         12,
+	}
+
+	if err := k.RegisterEVMChain(ctx, chain); err != nil {
+		t.Fatalf("RegisterEVMChain: %v", err)
+	}
+
+	got, found := k.GetEVMChain(ctx, 5)
+	if !found {
+		t.Fatalf("GetEVMChain(5): not found")
+	}
+	if got != chain {
+		t.Errorf("GetEVMChain(5) = %+v, want %+v", got, chain)
+	}
+
+	if _, found := k.GetEVMChain(ctx, 6); found {
+		t.Errorf("GetEVMChain(6) unexpectedly found a chain")
+	}
+}
+
+func TestRegisterEVMChainRejectsDuplicateChainID(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	chain := types.EVMChain{
+		ChainId:               5,
+		Name:                  "test-chain",
+		BridgeContractAddress: "0x0000000000000000000000000000000000000001",
+		StartBlock:            10,
+		FinalityDepth:         12,
+	}
+	if err := k.RegisterEVMChain(ctx, chain); err != nil {
+		t.Fatalf("first RegisterEVMChain: %v", err)
+	}
+
+	chain.Name = "renamed"
+	if err := k.RegisterEVMChain(ctx, chain); err == nil {
+		t.Fatalf("RegisterEVMChain with an already-registered chain_id did not error")
+	}
+}
+
+func TestRegisterEVMChainRejectsInvalidChain(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	if err := k.RegisterEVMChain(ctx, types.EVMChain{}); err == nil {
+		t.Fatalf("RegisterEVMChain with an empty EVMChain did not error")
+	}
+}
+
+func TestAllEVMChainsReturnsEveryRegisteredChain(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	for _, chainID := range []uint64{1, 2, 3} {
+		chain := types.EVMChain{
+			ChainId:               chainID,
+			Name:                  "chain",
+			BridgeContractAddress: "0x0000000000000000000000000000000000000001",
+			StartBlock:            1,
+			FinalityDepth:         1,
+		}
+		if err := k.RegisterEVMChain(ctx, chain); err != nil {
+			t.Fatalf("RegisterEVMChain(%d): %v", chainID, err)
+		}
+	}
+
+	chains := k.AllEVMChains(ctx)
+	if len(chains) != 3 {
+		t.Fatalf("AllEVMChains returned %d chains, want 3", len(chains))
+	}
+}
+
+// Valset confirmations are scoped by chain_id: the same validator confirming
+// the same nonce on two different chains must not collide.
+func TestValsetConfirmScopedByChainID(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	validator := sdk.ValAddress("validatoraddr-------")
+
+	k.SetValsetConfirm(ctx, 1, 7, validator, []byte("sig-chain-1"))
+	k.SetValsetConfirm(ctx, 2, 7, validator, []byte("sig-chain-2"))
+
+	got1 := k.GetValsetConfirm(ctx, 1, 7, validator)
+	got2 := k.GetValsetConfirm(ctx, 2, 7, validator)
+
+	if string(got1) != "sig-chain-1" {
+		t.Errorf("GetValsetConfirm(chain 1) = %q, want %q", got1, "sig-chain-1")
+	}
+	if string(got2) != "sig-chain-2" {
+		t.Errorf("GetValsetConfirm(chain 2) = %q, want %q", got2, "sig-chain-2")
+	}
+
+	if got := k.GetValsetConfirm(ctx, 3, 7, validator); got != nil {
+		t.Errorf("GetValsetConfirm(chain 3) = %q, want nil", got)
+	}
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/migrations/v2"
+)
+
+// Migrator is a wrapper around the peggy keeper that implements the
+// module.MigrationHandler interface expected by the SDK's module manager.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator for the given peggy keeper.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the peggy module's state from consensus version 1 to
+// 2, moving legacy single-chain valsets and attestations into the default
+// chain's namespace so the multi-EVM feature is backward-compatible on live
+// chains.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/types"
+)
+
+// SubmitBridgeEvidence verifies and processes a MsgSubmitBridgeEvidence. It
+// slashes and jails the accused validator once the evidence is confirmed to
+// have been produced by that validator's own operator key, and records the
+// evidence so it can later be enumerated via the Evidence query.
+func (k Keeper) SubmitBridgeEvidence(ctx sdk.Context, msg *types.MsgSubmitBridgeEvidence) (*types.MsgSubmitBridgeEvidenceResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.Submitter); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid submitter address")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid validator_address")
+	}
+
+	hasFalseClaim := msg.FalseClaim != nil
+	hasDoubleSign := msg.DoubleSign != nil
+	if hasFalseClaim == hasDoubleSign {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "exactly one of false_claim or double_sign must be set")
+	}
+
+	var chainID uint64
+	var evidenceType string
+	switch {
+	case hasDoubleSign:
+		// DoubleSignEvidence is not verified: that would need the ECDSA
+		// signer of each submitted checkpoint signature to be recovered and
+		// tied to the accused validator, and this module keeps no record of
+		// which Ethereum key a validator operator signs with. Reject up front instead of pretending to verify it - and risking a
+		// validator getting slashed on a check that can't actually prove
+		// anything.
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotSupported, "double-sign evidence is not supported yet: ECDSA signature recovery is not wired up")
+	case hasFalseClaim:
+		chainID = msg.FalseClaim.ChainId
+		evidenceType = types.AttributeValueFalseClaim
+		if err := verifyFalseClaimEvidence(msg.FalseClaim, msg.ValidatorAddress); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	validator := k.stakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "validator %s not found", msg.ValidatorAddress)
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "resolve validator consensus address")
+	}
+
+	fraction := k.GetParams(ctx).EvidenceSlashFraction
+	power := validator.GetConsensusPower(sdk.DefaultPowerReduction)
+	k.slashingKeeper.Slash(ctx, consAddr, fraction, power, ctx.BlockHeight())
+	k.slashingKeeper.Jail(ctx, consAddr)
+
+	k.SetBridgeEvidence(ctx, chainID, valAddr, ctx.BlockHeight(), msg)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBridgeSlash,
+			sdk.NewAttribute(types.AttributeKeyChainID, fmt.Sprintf("%d", chainID)),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEvidenceType, evidenceType),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, fraction.String()),
+		),
+	)
+
+	return &types.MsgSubmitBridgeEvidenceResponse{}, nil
+}
+
+// verifyFalseClaimEvidence checks that both claims share a chain_id and
+// event_nonce but diverged on the observed hash, and that both were claimed
+// by validatorAddress - the validator the submitter accuses - so a submitter
+// can't pin two claims made by someone else onto an unrelated validator.
+func verifyFalseClaimEvidence(ev *types.FalseClaimEvidence, validatorAddress string) error {
+	if ev.ClaimA == nil || ev.ClaimB == nil {
+		return fmt.Errorf("both claim_a and claim_b are required")
+	}
+	if ev.ClaimA.ChainId != ev.ClaimB.ChainId || ev.ClaimA.ChainId != ev.ChainId {
+		return fmt.Errorf("claim_a, claim_b and the evidence must share the same chain_id")
+	}
+	if ev.ClaimA.EventNonce != ev.ClaimB.EventNonce {
+		return fmt.Errorf("claim_a and claim_b must be for the same event_nonce")
+	}
+	if bytes.Equal(ev.ClaimA.Hash, ev.ClaimB.Hash) {
+		return fmt.Errorf("claim_a and claim_b have the same hash, not a false claim")
+	}
+	if ev.ClaimA.EventClaimer != ev.ClaimB.EventClaimer {
+		return fmt.Errorf("claim_a and claim_b must be claimed by the same validator")
+	}
+	if ev.ClaimA.EventClaimer != validatorAddress {
+		return fmt.Errorf("claim_a and claim_b must be claimed by validator_address %s, got event_claimer %s", validatorAddress, ev.ClaimA.EventClaimer)
+	}
+	return nil
+}
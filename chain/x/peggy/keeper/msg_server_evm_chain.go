@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/types"
+)
+
+// MsgRegisterEVMChain handles MsgRegisterEVMChain, the governance entry
+// point for onboarding a new EVM chain without a binary upgrade. It only
+// accepts the message from k.authority (the gov module account in a
+// standard app wiring).
+func (k Keeper) MsgRegisterEVMChain(ctx sdk.Context, msg *types.MsgRegisterEVMChain) (*types.MsgRegisterEVMChainResponse, error) {
+	if k.authority == "" || msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: %s", msg.Authority)
+	}
+
+	chain := types.EVMChain{
+		ChainId:               msg.ChainId,
+		Name:                  msg.Name,
+		BridgeContractAddress: msg.BridgeContractAddress,
+		StartBlock:            msg.StartBlock,
+		FinalityDepth:         msg.FinalityDepth,
+	}
+	if err := k.RegisterEVMChain(ctx, chain); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgRegisterEVMChainResponse{}, nil
+}
@@ -0,0 +1,30 @@
+package v2
+
+import "encoding/binary"
+
+// These mirror the unscoped key prefixes x/peggy/types used before chain_id
+// namespacing (consensus version 1). They must stay byte-for-byte identical
+// to the old types.ValsetRequestKey / types.OracleAttestationKey so
+// MigrateStore can find every legacy entry.
+var (
+	ValsetRequestKey     = []byte{0x2}
+	OracleAttestationKey = []byte{0x4}
+)
+
+func uint64Bytes(n uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, n)
+	return b
+}
+
+// GetValsetRequestKey returns the legacy (unscoped) valset request key for a
+// nonce.
+func GetValsetRequestKey(nonce uint64) []byte {
+	return append(ValsetRequestKey, uint64Bytes(nonce)...)
+}
+
+// GetOracleAttestationKey returns the legacy (unscoped) attestation key for
+// an event nonce.
+func GetOracleAttestationKey(eventNonce uint64) []byte {
+	return append(OracleAttestationKey, uint64Bytes(eventNonce)...)
+}
@@ -0,0 +1,117 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/types"
+)
+
+// MigrateStore decodes every legacy (unscoped) valset and attestation entry
+// with the frozen v1 schema in this package and re-encodes it into the
+// current, chain_id-scoped schema under types.DefaultChainID. This keeps
+// existing single-chain state readable once multi-EVM support (chunk0-1)
+// ships, without requiring validators to re-sync.
+//
+// Outgoing batches and logic calls are not migrated here: those types don't
+// exist in this module yet, so there is nothing on disk to carry forward.
+// Add their migration alongside the types that introduce them.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	if err := migrateValsets(ctx, storeKey, cdc); err != nil {
+		return err
+	}
+	return migrateAttestations(ctx, storeKey, cdc)
+}
+
+func migrateValsets(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	oldStore := prefix.NewStore(store, ValsetRequestKey)
+
+	// The new, chain_id-scoped key for a migrated entry still begins with
+	// the same single-byte prefix (ValsetRequestKey) that oldStore iterates
+	// over, so writing it while the iterator is live would let the iterator
+	// walk onto the entry we just migrated and migrate it a second time.
+	// Collect every legacy entry first, close the iterator, then write.
+	type legacyValset struct {
+		oldKey   []byte
+		migrated *types.Valset
+	}
+	var entries []legacyValset
+
+	iterator := oldStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var legacy Valset
+		if err := legacy.Unmarshal(iterator.Value()); err != nil {
+			iterator.Close()
+			return err
+		}
+
+		members := make([]*types.BridgeValidator, len(legacy.Members))
+		for i, m := range legacy.Members {
+			members[i] = &types.BridgeValidator{
+				Power:           m.Power,
+				EthereumAddress: m.EthereumAddress,
+				ChainId:         types.DefaultChainID,
+			}
+		}
+
+		entries = append(entries, legacyValset{
+			oldKey: append(ValsetRequestKey, iterator.Key()...),
+			migrated: &types.Valset{
+				Nonce:   legacy.Nonce,
+				Members: members,
+				Height:  legacy.Height,
+				ChainId: types.DefaultChainID,
+			},
+		})
+	}
+	iterator.Close()
+
+	for _, e := range entries {
+		store.Set(types.GetValsetRequestKey(types.DefaultChainID, e.migrated.Nonce), cdc.MustMarshal(e.migrated))
+		store.Delete(e.oldKey)
+	}
+	return nil
+}
+
+func migrateAttestations(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	oldStore := prefix.NewStore(store, OracleAttestationKey)
+
+	// Same hazard as migrateValsets: the migrated key shares OracleAttestationKey's
+	// prefix with the entry being iterated, so collect every legacy entry
+	// before writing any of the migrated ones.
+	type legacyClaim struct {
+		oldKey   []byte
+		migrated *types.GenericClaim
+	}
+	var entries []legacyClaim
+
+	iterator := oldStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var legacy GenericClaim
+		if err := legacy.Unmarshal(iterator.Value()); err != nil {
+			iterator.Close()
+			return err
+		}
+
+		entries = append(entries, legacyClaim{
+			oldKey: append(OracleAttestationKey, iterator.Key()...),
+			migrated: &types.GenericClaim{
+				EventNonce:   legacy.EventNonce,
+				ClaimType:    legacy.ClaimType,
+				Hash:         legacy.Hash,
+				EventClaimer: legacy.EventClaimer,
+				ChainId:      types.DefaultChainID,
+			},
+		})
+	}
+	iterator.Close()
+
+	for _, e := range entries {
+		store.Set(types.GetOracleAttestationKey(types.DefaultChainID, e.migrated.EventNonce, e.migrated.Hash), cdc.MustMarshal(e.migrated))
+		store.Delete(e.oldKey)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package peggy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/keeper"
+	"github.com/EvgeniyTaburyanskiy/minter-hub/chain/x/peggy/types"
+)
+
+// AppModule implements the genesis and migration surface of
+// module.AppModule for peggy: Name, ConsensusVersion, RegisterServices,
+// RegisterInvariants and the AppModuleGenesis methods below. It does not
+// implement AppModuleBasic's codec/CLI/REST registration methods
+// (RegisterLegacyAminoCodec, RegisterInterfaces, GetTxCmd, GetQueryCmd, ...)
+// or BeginBlock/EndBlock, since nothing in this tree defines peggy messages
+// as routable sdk.Msgs yet; it is not a drop-in for module.AppModule until
+// those are added.
+//
+// Genesis is encoded with plain encoding/json rather than the app-wide
+// codec.Codec: types.GenesisState is a plain JSON-tagged struct, not a proto
+// message (it embeds types.Params, which holds an sdk.Dec with no proto
+// descriptor of its own), so it can't satisfy the proto.Message contract
+// codec.Codec.MarshalJSON/UnmarshalJSON require.
+type AppModule struct {
+	keeper keeper.Keeper
+}
+
+// NewAppModule creates a new AppModule for the peggy module.
+func NewAppModule(k keeper.Keeper) AppModule {
+	return AppModule{keeper: k}
+}
+
+// Name returns the peggy module's name.
+func (AppModule) Name() string { return types.ModuleName }
+
+// RegisterInvariants registers the peggy module's invariants. Peggy defines
+// none yet.
+func (AppModule) RegisterInvariants(sdk.InvariantRegistry) {}
+
+// DefaultGenesis returns peggy's default genesis state.
+func (AppModule) DefaultGenesis() json.RawMessage {
+	bz, err := json.Marshal(types.DefaultGenesis())
+	if err != nil {
+		panic(fmt.Errorf("marshal default peggy genesis: %w", err))
+	}
+	return bz
+}
+
+// ValidateGenesis checks that the given genesis state is well formed.
+func (AppModule) ValidateGenesis(bz json.RawMessage) error {
+	var genState types.GenesisState
+	if err := json.Unmarshal(bz, &genState); err != nil {
+		return err
+	}
+	return genState.Validate()
+}
+
+// InitGenesis initializes peggy's state from its genesis data.
+func (am AppModule) InitGenesis(ctx sdk.Context, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genState types.GenesisState
+	if err := json.Unmarshal(gs, &genState); err != nil {
+		panic(fmt.Errorf("unmarshal peggy genesis: %w", err))
+	}
+	keeper.InitGenesis(ctx, am.keeper, genState)
+	return nil
+}
+
+// ExportGenesis returns peggy's exported genesis state.
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	bz, err := json.Marshal(keeper.ExportGenesis(ctx, am.keeper))
+	if err != nil {
+		panic(fmt.Errorf("marshal peggy genesis: %w", err))
+	}
+	return bz
+}
+
+// ConsensusVersion bumps to 2 now that chain_id scoping (chunk0-1) changed
+// the on-chain valset/attestation schema; Migrate1to2 carries existing state
+// forward.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterServices registers the module's migrations with the module
+// manager so Migrate1to2 runs automatically during the next upgrade
+// handler that bumps peggy's consensus version.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
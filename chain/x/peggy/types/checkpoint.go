@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SortedMembers returns a copy of members ordered by power descending, then
+// by EthereumAddress ascending. This is the canonical order both Checkpoint
+// and the peggyjson encoding use, so a checkpoint hash computed from JSON
+// members always matches one computed from the protobuf form.
+func SortedMembers(members []*BridgeValidator) []*BridgeValidator {
+	sorted := make([]*BridgeValidator, len(members))
+	copy(sorted, members)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Power != sorted[j].Power {
+			return sorted[i].Power > sorted[j].Power
+		}
+		return sorted[i].EthereumAddress < sorted[j].EthereumAddress
+	})
+	return sorted
+}
+
+// checkpointArgs mirrors the Solidity-side ABI encoding the Peggy bridge
+// contract's `checkpoint` function uses to compute the hash validators sign.
+var checkpointArgs = abi.Arguments{
+	{Type: mustType("bytes32")},
+	{Type: mustType("string")},
+	{Type: mustType("string")},
+	{Type: mustType("uint256")},
+	{Type: mustType("address[]")},
+	{Type: mustType("uint256[]")},
+}
+
+func mustType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(fmt.Errorf("build peggy checkpoint ABI type %q: %w", t, err))
+	}
+	return typ
+}
+
+// Checkpoint returns the keccak256 digest the Peggy bridge contract expects
+// a quorum of this Valset's members to have signed, matching the contract's
+// own `checkpoint` computation byte for byte:
+//
+//	keccak256(abi.encode(peggyID, "checkpoint", bridgeVersion, nonce, addresses[], powers[]))
+//
+// peggyID distinguishes one deployment of the bridge contract from another
+// (e.g. testnet vs mainnet); bridgeVersion lets the contract reject
+// checkpoints signed under a since-upgraded schema. Members are hashed in
+// SortedMembers order, so the digest doesn't depend on the order they were
+// stored or requested in.
+func (v *Valset) Checkpoint(peggyID, bridgeVersion string) common.Hash {
+	members := SortedMembers(v.Members)
+
+	addresses := make([]common.Address, len(members))
+	powers := make([]*big.Int, len(members))
+	for i, m := range members {
+		addresses[i] = common.HexToAddress(m.EthereumAddress)
+		powers[i] = new(big.Int).SetUint64(m.Power)
+	}
+
+	var peggyIDBytes [32]byte
+	copy(peggyIDBytes[:], []byte(peggyID))
+
+	packed, err := checkpointArgs.Pack(
+		peggyIDBytes,
+		"checkpoint",
+		bridgeVersion,
+		new(big.Int).SetUint64(v.Nonce),
+		addresses,
+		powers,
+	)
+	if err != nil {
+		panic(fmt.Errorf("pack peggy checkpoint for valset %d: %w", v.Nonce, err))
+	}
+	return crypto.Keccak256Hash(packed)
+}
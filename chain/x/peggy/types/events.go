@@ -0,0 +1,13 @@
+package types
+
+// Event types and attribute keys emitted by the peggy module.
+const (
+	EventTypeBridgeSlash = "bridge_slash"
+
+	AttributeKeyChainID       = "chain_id"
+	AttributeKeyValidator     = "validator"
+	AttributeKeyEvidenceType  = "evidence_type"
+	AttributeKeySlashFraction = "slash_fraction"
+	AttributeValueDoubleSign  = "double_sign"
+	AttributeValueFalseClaim  = "false_claim"
+)
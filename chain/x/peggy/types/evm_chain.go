@@ -0,0 +1,42 @@
+package types
+
+import "fmt"
+
+// EVMChain describes a single EVM-compatible chain that this hub bridges to.
+// It is registered via governance (or genesis) before any valset, attestation
+// or batch can be created for the chain, so onboarding a new EVM chain never
+// requires a binary upgrade.
+type EVMChain struct {
+	// ChainId is the arbitrary, hub-assigned identifier used to namespace
+	// every peggy store key and proto message for this chain. It is distinct
+	// from the EVM chain's own chain id.
+	ChainId uint64 `json:"chain_id"`
+	// Name is a human-readable label, e.g. "ethereum-mainnet" or "polygon".
+	Name string `json:"name"`
+	// BridgeContractAddress is the 0x-prefixed address of the Peggy bridge
+	// contract deployed on this EVM chain.
+	BridgeContractAddress string `json:"bridge_contract_address"`
+	// StartBlock is the EVM block height the orchestrator should begin
+	// scanning from when relaying events for this chain.
+	StartBlock uint64 `json:"start_block"`
+	// FinalityDepth is the number of confirmations the orchestrator must
+	// wait for before treating an EVM block as final on this chain.
+	FinalityDepth uint64 `json:"finality_depth"`
+}
+
+// ValidateBasic performs stateless sanity checks on an EVMChain registration.
+func (c EVMChain) ValidateBasic() error {
+	if c.ChainId == 0 {
+		return fmt.Errorf("chain_id must be non-zero")
+	}
+	if c.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if len(c.BridgeContractAddress) != 42 || c.BridgeContractAddress[:2] != "0x" {
+		return fmt.Errorf("bridge_contract_address must be a 0x-prefixed 20 byte address")
+	}
+	if c.FinalityDepth == 0 {
+		return fmt.Errorf("finality_depth must be non-zero")
+	}
+	return nil
+}
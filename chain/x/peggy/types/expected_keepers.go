@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper defines the staking functionality peggy depends on to
+// resolve a validator's consensus key and current power.
+type StakingKeeper interface {
+	Validator(ctx sdk.Context, addr sdk.ValAddress) stakingtypes.ValidatorI
+}
+
+// SlashingKeeper defines the slashing functionality peggy depends on to
+// punish validators who submit conflicting bridge evidence.
+type SlashingKeeper interface {
+	Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64)
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+}
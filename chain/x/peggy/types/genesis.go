@@ -0,0 +1,38 @@
+package types
+
+import "fmt"
+
+// GenesisState defines the peggy module's genesis state. EVMChains lets a
+// chain launch already bridging to one or more EVM chains without waiting
+// for a post-genesis governance proposal.
+type GenesisState struct {
+	Params    Params     `json:"params"`
+	EVMChains []EVMChain `json:"evm_chains"`
+}
+
+// DefaultGenesis returns the default peggy genesis state: default params and
+// no EVM chains registered.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:    DefaultParams(),
+		EVMChains: []EVMChain{},
+	}
+}
+
+// Validate performs stateless validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	seen := make(map[uint64]bool, len(gs.EVMChains))
+	for _, chain := range gs.EVMChains {
+		if err := chain.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid genesis EVM chain: %w", err)
+		}
+		if seen[chain.ChainId] {
+			return fmt.Errorf("duplicate genesis EVM chain_id %d", chain.ChainId)
+		}
+		seen[chain.ChainId] = true
+	}
+	if err := validateEvidenceSlashFraction(gs.Params.EvidenceSlashFraction); err != nil {
+		return err
+	}
+	return validateBLSTransitionHeight(gs.Params.BLSTransitionHeight)
+}
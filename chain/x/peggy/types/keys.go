@@ -0,0 +1,152 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName is the name of the peggy module
+	ModuleName = "peggy"
+
+	// StoreKey is the store key string for peggy
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for peggy
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for peggy
+	QuerierRoute = ModuleName
+)
+
+// DefaultChainID is the chain_id used for all valsets, attestations, batches
+// and event nonces that were written before multi-EVM support was added.
+// Migrate1to2 moves the pre-existing single-chain store into this chain_id's
+// namespace.
+const DefaultChainID uint64 = 1
+
+var (
+	// EVMChainKey indexes registered EVMChain params by chain_id
+	EVMChainKey = []byte{0x1}
+
+	// ValsetRequestKey indexes valset requests by chain_id and nonce
+	ValsetRequestKey = []byte{0x2}
+
+	// ValsetConfirmKey indexes valset confirmations by chain_id, nonce and validator
+	ValsetConfirmKey = []byte{0x3}
+
+	// OracleAttestationKey indexes attestations by chain_id, event nonce and claim hash
+	OracleAttestationKey = []byte{0x4}
+
+	// OutgoingTXBatchKey indexes outgoing batches by chain_id and nonce
+	OutgoingTXBatchKey = []byte{0x5}
+
+	// OutgoingLogicCallKey indexes outgoing logic calls by chain_id, invalidation id and nonce
+	OutgoingLogicCallKey = []byte{0x6}
+
+	// LastEventNonceByValidatorKey indexes the last observed event nonce per
+	// chain_id and validator, so event nonces are tracked independently per
+	// bridged EVM chain.
+	LastEventNonceByValidatorKey = []byte{0x7}
+
+	// LastObservedEventNonceKey indexes the last globally observed event
+	// nonce per chain_id.
+	LastObservedEventNonceKey = []byte{0x8}
+
+	// BridgeEvidenceKey indexes historical bridge slashing evidence by
+	// chain_id and the slashed validator's operator address, so a watchtower
+	// can enumerate every slash a validator has ever incurred.
+	BridgeEvidenceKey = []byte{0x9}
+
+	// BridgeEvidenceSequenceKey stores the next sequence number to assign to
+	// a piece of bridge slashing evidence, so two pieces of evidence against
+	// the same validator landing in the same block don't overwrite each
+	// other under GetBridgeEvidenceKey.
+	BridgeEvidenceSequenceKey = []byte{0xa}
+)
+
+// chainIDBytes encodes a chain_id as big-endian so store keys sort in
+// numeric order per chain.
+func chainIDBytes(chainID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, chainID)
+	return b
+}
+
+// UInt64Bytes encodes a uint64 (nonce, height, ...) as big-endian, matching
+// the SDK convention of sortable store keys.
+func UInt64Bytes(n uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, n)
+	return b
+}
+
+// GetEVMChainKey returns the store key for an EVMChain registration.
+func GetEVMChainKey(chainID uint64) []byte {
+	return append(EVMChainKey, chainIDBytes(chainID)...)
+}
+
+// GetValsetRequestKey returns the store key for a valset request, scoped to
+// chain_id so several EVM chains can checkpoint independent valsets.
+func GetValsetRequestKey(chainID, nonce uint64) []byte {
+	return append(ValsetRequestKey, append(chainIDBytes(chainID), UInt64Bytes(nonce)...)...)
+}
+
+// GetValsetConfirmKey returns the store key for an orchestrator's valset
+// confirmation, scoped to chain_id, nonce and validator.
+func GetValsetConfirmKey(chainID, nonce uint64, validator []byte) []byte {
+	key := append(ValsetConfirmKey, chainIDBytes(chainID)...)
+	key = append(key, UInt64Bytes(nonce)...)
+	return append(key, validator...)
+}
+
+// GetOutgoingTXBatchKey returns the store key for an outgoing batch, scoped
+// to chain_id and nonce.
+func GetOutgoingTXBatchKey(chainID, nonce uint64) []byte {
+	return append(OutgoingTXBatchKey, append(chainIDBytes(chainID), UInt64Bytes(nonce)...)...)
+}
+
+// GetLastEventNonceByValidatorKey returns the store key tracking the last
+// event nonce a given validator has claimed on a given chain_id. Event
+// nonces are only monotonic within a single chain_id.
+func GetLastEventNonceByValidatorKey(chainID uint64, validator []byte) []byte {
+	return append(LastEventNonceByValidatorKey, append(chainIDBytes(chainID), validator...)...)
+}
+
+// GetLastObservedEventNonceKey returns the store key for the last event
+// nonce observed by consensus on a given chain_id.
+func GetLastObservedEventNonceKey(chainID uint64) []byte {
+	return append(LastObservedEventNonceKey, chainIDBytes(chainID)...)
+}
+
+// GetOracleAttestationKey returns the store key for an attestation, scoped
+// to chain_id, event nonce and claim hash. The hash has to be part of the
+// key: several orchestrators can submit GenericClaims for the same
+// (chain_id, event_nonce) whose hash disagrees - exactly the equivocation
+// FalseClaimEvidence exists to catch - and each distinct claim needs its own
+// attestation entry instead of silently overwriting whichever one was
+// stored first.
+func GetOracleAttestationKey(chainID, eventNonce uint64, hash []byte) []byte {
+	key := append(OracleAttestationKey, chainIDBytes(chainID)...)
+	key = append(key, UInt64Bytes(eventNonce)...)
+	return append(key, hash...)
+}
+
+// GetBridgeEvidenceKey returns the store key for a single piece of slashing
+// evidence, scoped to chain_id and the slashed validator, indexed by the
+// height it was processed at and then a per-evidence sequence number, so a
+// validator's history sorts in order and two pieces of evidence landing in
+// the same block never collide.
+func GetBridgeEvidenceKey(chainID uint64, validator []byte, height, sequence uint64) []byte {
+	key := append(BridgeEvidenceKey, chainIDBytes(chainID)...)
+	key = append(key, validator...)
+	key = append(key, UInt64Bytes(height)...)
+	return append(key, UInt64Bytes(sequence)...)
+}
+
+// GetBridgeEvidenceValidatorPrefix returns the key prefix under which every
+// piece of evidence against a validator on a given chain_id is stored, for
+// use with an iterator.
+func GetBridgeEvidenceValidatorPrefix(chainID uint64, validator []byte) []byte {
+	key := append(BridgeEvidenceKey, chainIDBytes(chainID)...)
+	return append(key, validator...)
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	ParamStoreKeyEvidenceSlashFraction = []byte("EvidenceSlashFraction")
+	ParamStoreKeyBLSTransitionHeight   = []byte("BLSTransitionHeight")
+)
+
+// Params holds the module's governance-configurable parameters.
+type Params struct {
+	// EvidenceSlashFraction is the fraction of a validator's power slashed
+	// when FalseClaimEvidence against them is accepted. DoubleSignEvidence is
+	// rejected by SubmitBridgeEvidence and never leads to a slash.
+	EvidenceSlashFraction sdk.Dec `json:"evidence_slash_fraction"`
+	// BLSTransitionHeight is the block height after which the module accepts
+	// BLS-aggregated valset confirmations in addition to the legacy
+	// per-validator ECDSA path. A value of 0 disables BLS aggregation
+	// entirely, which is the default: the bridge contract must be upgraded
+	// with a pairing precompile check before any chain can safely enable it.
+	BLSTransitionHeight int64 `json:"bls_transition_height"`
+}
+
+// DefaultEvidenceSlashFraction matches the SDK's default double-sign slash
+// fraction; bridge equivocation is judged as severely as consensus
+// equivocation.
+var DefaultEvidenceSlashFraction = sdk.NewDecWithPrec(5, 2) // 5%
+
+// DefaultParams returns the default peggy module parameters.
+func DefaultParams() Params {
+	return Params{
+		EvidenceSlashFraction: DefaultEvidenceSlashFraction,
+		BLSTransitionHeight:   0,
+	}
+}
+
+// ParamKeyTable returns the param key table for the peggy module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the paramtypes.ParamSet interface.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEvidenceSlashFraction, &p.EvidenceSlashFraction, validateEvidenceSlashFraction),
+		paramtypes.NewParamSetPair(ParamStoreKeyBLSTransitionHeight, &p.BLSTransitionHeight, validateBLSTransitionHeight),
+	}
+}
+
+func validateEvidenceSlashFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("evidence slash fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateBLSTransitionHeight(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("bls transition height cannot be negative: %d", v)
+	}
+	return nil
+}
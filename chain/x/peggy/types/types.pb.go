@@ -26,6 +26,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type BridgeValidator struct {
 	Power           uint64 `protobuf:"varint,1,opt,name=power,proto3" json:"power,omitempty"`
 	EthereumAddress string `protobuf:"bytes,2,opt,name=ethereum_address,json=ethereumAddress,proto3" json:"ethereum_address,omitempty"`
+	// chain_id identifies which EVM chain this bridge validator's power and
+	// address apply to, allowing the same hub validator set to be represented
+	// on several bridged chains at once.
+	ChainId uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// bls_pubkey is the validator's compressed BLS12-381 G1 public key, set
+	// once the orchestrator opts into aggregated valset confirmations. It is
+	// empty for validators still on the legacy per-signature ECDSA path.
+	BlsPubkey []byte `protobuf:"bytes,4,opt,name=bls_pubkey,json=blsPubkey,proto3" json:"bls_pubkey,omitempty"`
 }
 
 func (m *BridgeValidator) Reset()         { *m = BridgeValidator{} }
@@ -75,6 +83,20 @@ func (m *BridgeValidator) GetEthereumAddress() string {
 	return ""
 }
 
+func (m *BridgeValidator) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *BridgeValidator) GetBlsPubkey() []byte {
+	if m != nil {
+		return m.BlsPubkey
+	}
+	return nil
+}
+
 // Valset is the Ethereum Bridge Multsig Set, each peggy validator also
 // maintains an ETH key to sign messages, these are used to check signatures on
 // ETH because of the significant gas savings
@@ -82,6 +104,9 @@ type Valset struct {
 	Nonce   uint64             `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	Members []*BridgeValidator `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
 	Height  uint64             `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// chain_id scopes this valset request/confirmation to a single bridged EVM
+	// chain so a hub validator set can be checkpointed independently per chain.
+	ChainId uint64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *Valset) Reset()         { *m = Valset{} }
@@ -138,6 +163,13 @@ func (m *Valset) GetHeight() uint64 {
 	return 0
 }
 
+func (m *Valset) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
 // It's difficult to serialize and deserialize
 // interfaces, instead we can make this struct
 // that stores all the data the interface requires
@@ -148,6 +180,9 @@ type GenericClaim struct {
 	ClaimType    int32  `protobuf:"varint,2,opt,name=claim_type,json=claimType,proto3" json:"claim_type,omitempty"`
 	Hash         []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
 	EventClaimer string `protobuf:"bytes,4,opt,name=event_claimer,json=eventClaimer,proto3" json:"event_claimer,omitempty"`
+	// chain_id identifies which bridged EVM chain emitted the event this claim
+	// attests to. event_nonce is only unique within a single chain_id.
+	ChainId uint64 `protobuf:"varint,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *GenericClaim) Reset()         { *m = GenericClaim{} }
@@ -211,10 +246,96 @@ func (m *GenericClaim) GetEventClaimer() string {
 	return ""
 }
 
+func (m *GenericClaim) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// ValsetConfirmAggregate is a single BLS12-381 signature aggregating every
+// participating validator's confirmation of a Valset checkpoint for one
+// chain_id/nonce, together with a bitmap of which validators (by their index
+// into the Valset's Members, in order) signed. It replaces O(n) ECDSA
+// signatures with one pairing check on the bridge contract once cumulative
+// signer power for a nonce crosses 2/3.
+type ValsetConfirmAggregate struct {
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce   uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// signer_bitmap has one bit per Valset.Members entry, set if that
+	// validator's BLS signature is included in aggregate_signature.
+	SignerBitmap []byte `protobuf:"bytes,3,opt,name=signer_bitmap,json=signerBitmap,proto3" json:"signer_bitmap,omitempty"`
+	// aggregate_signature is the compressed BLS12-381 G2 signature produced by
+	// summing the individual signatures of every bit set in signer_bitmap.
+	AggregateSignature []byte `protobuf:"bytes,4,opt,name=aggregate_signature,json=aggregateSignature,proto3" json:"aggregate_signature,omitempty"`
+}
+
+func (m *ValsetConfirmAggregate) Reset()         { *m = ValsetConfirmAggregate{} }
+func (m *ValsetConfirmAggregate) String() string { return proto.CompactTextString(m) }
+func (*ValsetConfirmAggregate) ProtoMessage()    {}
+func (*ValsetConfirmAggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1488ca6080c6185d, []int{3}
+}
+func (m *ValsetConfirmAggregate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValsetConfirmAggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValsetConfirmAggregate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValsetConfirmAggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValsetConfirmAggregate.Merge(m, src)
+}
+func (m *ValsetConfirmAggregate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValsetConfirmAggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValsetConfirmAggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValsetConfirmAggregate proto.InternalMessageInfo
+
+func (m *ValsetConfirmAggregate) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ValsetConfirmAggregate) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ValsetConfirmAggregate) GetSignerBitmap() []byte {
+	if m != nil {
+		return m.SignerBitmap
+	}
+	return nil
+}
+
+func (m *ValsetConfirmAggregate) GetAggregateSignature() []byte {
+	if m != nil {
+		return m.AggregateSignature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BridgeValidator)(nil), "peggy.v1.BridgeValidator")
 	proto.RegisterType((*Valset)(nil), "peggy.v1.Valset")
 	proto.RegisterType((*GenericClaim)(nil), "peggy.v1.GenericClaim")
+	proto.RegisterType((*ValsetConfirmAggregate)(nil), "peggy.v1.ValsetConfirmAggregate")
 }
 
 func init() { proto.RegisterFile("peggy/v1/types.proto", fileDescriptor_1488ca6080c6185d) }
@@ -264,6 +385,18 @@ func (m *BridgeValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlsPubkey) > 0 {
+		i -= len(m.BlsPubkey)
+		copy(dAtA[i:], m.BlsPubkey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BlsPubkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.EthereumAddress) > 0 {
 		i -= len(m.EthereumAddress)
 		copy(dAtA[i:], m.EthereumAddress)
@@ -299,6 +432,11 @@ func (m *Valset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Height != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
 		i--
@@ -346,6 +484,11 @@ func (m *GenericClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.EventClaimer) > 0 {
 		i -= len(m.EventClaimer)
 		copy(dAtA[i:], m.EventClaimer)
@@ -373,6 +516,53 @@ func (m *GenericClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValsetConfirmAggregate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValsetConfirmAggregate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValsetConfirmAggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregateSignature) > 0 {
+		i -= len(m.AggregateSignature)
+		copy(dAtA[i:], m.AggregateSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AggregateSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SignerBitmap) > 0 {
+		i -= len(m.SignerBitmap)
+		copy(dAtA[i:], m.SignerBitmap)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SignerBitmap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -397,6 +587,13 @@ func (m *BridgeValidator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.ChainId != 0 {
+		n += 1 + sovTypes(uint64(m.ChainId))
+	}
+	l = len(m.BlsPubkey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -418,6 +615,9 @@ func (m *Valset) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovTypes(uint64(m.Height))
 	}
+	if m.ChainId != 0 {
+		n += 1 + sovTypes(uint64(m.ChainId))
+	}
 	return n
 }
 
@@ -441,6 +641,32 @@ func (m *GenericClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.ChainId != 0 {
+		n += 1 + sovTypes(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *ValsetConfirmAggregate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovTypes(uint64(m.ChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	l = len(m.SignerBitmap)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AggregateSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -530,6 +756,59 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 			}
 			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubkey = append(m.BlsPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsPubkey == nil {
+				m.BlsPubkey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -655,6 +934,25 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -812,6 +1110,184 @@ func (m *GenericClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.EventClaimer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValsetConfirmAggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValsetConfirmAggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValsetConfirmAggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerBitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerBitmap = append(m.SignerBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignerBitmap == nil {
+				m.SignerBitmap = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateSignature = append(m.AggregateSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregateSignature == nil {
+				m.AggregateSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])